@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/andromedaprotocol/andromedad/x/distribution/types"
+)
+
+const (
+	flagAll        = "all"
+	flagCommission = "commission"
+)
+
+// NewWithdrawRewardsCmd returns a CLI command for withdrawing delegation
+// rewards, either for a single validator or, with --all, for every
+// delegation belonging to the delegator signing the transaction.
+func NewWithdrawRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-rewards [validator-addr]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Withdraw rewards from a given delegation address, or all of the delegator's delegations with --all",
+		Long: `Withdraw rewards for a delegation, specified by a validator address.
+
+Pass --all instead of a validator address to withdraw rewards across every
+active delegation for the signing delegator in a single transaction; add
+--commission in that case to also withdraw the delegator's own validator
+commission, if it operates one.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			withdrawAll, err := cmd.Flags().GetBool(flagAll)
+			if err != nil {
+				return err
+			}
+
+			if withdrawAll {
+				withdrawCommission, err := cmd.Flags().GetBool(flagCommission)
+				if err != nil {
+					return err
+				}
+
+				msg := &types.MsgWithdrawDelegatorRewardsAll{
+					DelegatorAddress:   clientCtx.GetFromAddress().String(),
+					WithdrawCommission: withdrawCommission,
+				}
+
+				return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			}
+
+			if len(args) != 1 {
+				return fmt.Errorf("validator address is required unless --%s is set", flagAll)
+			}
+
+			withdrawCommission, err := cmd.Flags().GetBool(flagCommission)
+			if err != nil {
+				return err
+			}
+
+			// A single withdrawal uses the standard SDK distribution
+			// messages directly; MsgWithdrawDelegatorRewardsAll only exists
+			// for the --all case above, which those upstream messages can't
+			// express.
+			msgs := []sdk.Msg{
+				&distrtypes.MsgWithdrawDelegatorReward{
+					DelegatorAddress: clientCtx.GetFromAddress().String(),
+					ValidatorAddress: args[0],
+				},
+			}
+
+			if withdrawCommission {
+				msgs = append(msgs, &distrtypes.MsgWithdrawValidatorCommission{
+					ValidatorAddress: args[0],
+				})
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	cmd.Flags().Bool(flagAll, false, "Withdraw rewards for all of the delegator's delegations instead of a single validator")
+	cmd.Flags().Bool(flagCommission, false, "Also withdraw validator commission: with --all, that of the validator the delegator operates, if any; otherwise, that of the given validator")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -9,13 +9,17 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
 
 	"github.com/andromedaprotocol/andromedad/x/distribution/types"
 )
 
 // AllocateTokens performs reward and fee distribution to all validators based
-// on the F1 fee distribution specification.
-func (k Keeper) AllocateTokens(ctx context.Context, totalPreviousPower int64, bondedVotes []abci.VoteInfo) error {
+// on the F1 fee distribution specification. previousProposer is the consensus
+// address of the validator that proposed the previous block, taken from the
+// commit info passed into BeginBlock, and is used to pay out the classic
+// proposer reward on top of the F1 proportional allocation.
+func (k Keeper) AllocateTokens(ctx context.Context, totalPreviousPower int64, previousProposer sdk.ConsAddress, bondedVotes []abci.VoteInfo) error {
 	// fetch and clear the collected fees for distribution, since this is
 	// called in BeginBlock, collected fees will be from the previous block
 	// (and distributed to the previous proposer)
@@ -36,30 +40,38 @@ func (k Keeper) AllocateTokens(ctx context.Context, totalPreviousPower int64, bo
 		return err
 	}
 
-	// Calculate rewards to be dripped this block from Param set
-	rewardsToDrip, err := k.GetRewardsPerBlock(ctx)
+	// Burn a configurable fraction of the collected fees before any
+	// validator or community allocation happens. Only fees are burned; the
+	// rewards-dripper flow below is untouched.
+	_, feesCollectedInt, err = k.feeburnKeeper.BurnFees(sdk.UnwrapSDKContext(ctx), types.ModuleName, feesCollectedInt)
 	if err != nil {
 		return err
 	}
+	feesCollected = sdk.NewDecCoinsFromCoins(feesCollectedInt...)
+
+	// Calculate rewards to be dripped this block per denom: a denom with a
+	// matching RewardsSchedule entry uses its halving curve, everything
+	// else falls back to the flat RewardsPerBlock rate. rawRewardsRateDec
+	// keeps the un-truncated rate per denom so the truncation loss below can
+	// still be measured after rounding to whole-unit Coins.
+	rawRewardsRateDec := sdk.NewDecCoins()
+	for _, coin := range rewardsDripperBalance {
+		rewardsToDrip, err := k.currentRewardsRate(ctx, coin.Denom)
+		if err != nil {
+			return err
+		}
 
-	// If rewardsToDrip is nil set to 0
-	if rewardsToDrip.IsNil() {
-		rewardsToDrip = math.LegacyZeroDec()
-	}
-	// Create new coins with the denoms of the rewardsDripperBalance and the amount of rewards to be dripped
-	rewardsCoins := make(sdk.Coins, len(rewardsDripperBalance))
-	for i, coin := range rewardsDripperBalance {
-		rewardsCoins[i] = sdk.NewCoin(coin.Denom, rewardsToDrip.TruncateInt())
-	}
-
-	// Convert to DecCoins
-	rewardsToDripDec := sdk.NewDecCoinsFromCoins(rewardsCoins...)
+		if rewardsToDrip.IsNil() {
+			rewardsToDrip = math.LegacyZeroDec()
+		}
 
-	// Intersect balance of rewardsDripper with rewardsToDripDec to find the amount to be dripped
-	rewardsToDripDec = rewardsToDripDec.Intersect(rewardsDripperCollected)
+		rawRewardsRateDec = rawRewardsRateDec.Add(sdk.NewDecCoinFromDec(coin.Denom, rewardsToDrip))
+	}
 
-	// Convert rewardsToDripDec to Coins
-	rewardsToDripInt, _ := rewardsToDripDec.TruncateDecimal()
+	// Cap the raw rate at what the dripper actually holds, and split it into
+	// the whole-unit Coins that get transferred out and the sub-unit dust
+	// left behind by truncation.
+	rewardsToDripInt, rewardsToDripDec, dust := types.SplitDripAmount(rawRewardsRateDec, rewardsDripperCollected)
 
 	// transfer rewards to be dripped to the distribution module account
 	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.RewardsDripperName, types.ModuleName, rewardsToDripInt); err != nil {
@@ -91,7 +103,41 @@ func (k Keeper) AllocateTokens(ctx context.Context, totalPreviousPower int64, bo
 		return err
 	}
 
-	voteMultiplier := math.LegacyOneDec().Sub(communityTax)
+	// pay the previous proposer its base + precommit-weighted bonus reward,
+	// computed from fees only; the rewards-dripper flow is never boosted.
+	baseProposerReward, err := k.GetBaseProposerReward(ctx)
+	if err != nil {
+		return err
+	}
+	bonusProposerReward, err := k.GetBonusProposerReward(ctx)
+	if err != nil {
+		return err
+	}
+
+	sumPrecommitPower := int64(0)
+	for _, vote := range bondedVotes {
+		if vote.BlockIdFlag == cmttypes.BlockIDFlagCommit {
+			sumPrecommitPower += vote.Validator.Power
+		}
+	}
+	proposerMultiplier := types.ProposerRewardMultiplier(baseProposerReward, bonusProposerReward, sumPrecommitPower, totalPreviousPower)
+
+	if proposerMultiplier.IsPositive() {
+		proposerReward := feesCollected.MulDecTruncate(proposerMultiplier)
+
+		proposerValidator, err := k.stakingKeeper.ValidatorByConsAddr(ctx, previousProposer)
+		if err != nil {
+			return err
+		}
+
+		if err := k.AllocateTokensToValidator(ctx, proposerValidator, proposerReward); err != nil {
+			return err
+		}
+
+		remaining = remaining.Sub(proposerReward)
+	}
+
+	voteMultiplier := math.LegacyOneDec().Sub(communityTax).Mul(math.LegacyOneDec().Sub(proposerMultiplier))
 	feeMultiplier := feesCollected.MulDecTruncate(voteMultiplier)
 
 	// To avoid adding a community tax to rewards to be dripped we add the rewardsToDripDec to the feeMultiplier
@@ -123,9 +169,32 @@ func (k Keeper) AllocateTokens(ctx context.Context, totalPreviousPower int64, bo
 		remaining = remaining.Sub(reward)
 	}
 
+	// dust is the sub-unit remainder of the raw per-denom rate (e.g. a
+	// 2.5/block rate truncated to 2) that is never transferred out of
+	// RewardsDripper — only rewardsToDripInt actually moves via
+	// SendCoinsFromModuleToModule above. It must NOT be credited to
+	// CommunityPool: doing so would inflate CommunityPool's ledger
+	// entitlement beyond what the distribution module account actually
+	// holds, since the backing tokens stay in RewardsDripper. It is only
+	// emitted as an event below, for observability into how much the
+	// truncation curve is losing per block.
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !dust.IsZero() {
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRewardsDripDust,
+				sdk.NewAttribute(types.AttributeKeyDust, dust.String()),
+			),
+		)
+	}
+
 	// allocate community funding
 	feePool.CommunityPool = feePool.CommunityPool.Add(remaining...)
-	return k.FeePool.Set(ctx, feePool)
+	if err := k.FeePool.Set(ctx, feePool); err != nil {
+		return err
+	}
+
+	return k.ConsolidateCommunityFunds(ctx)
 }
 
 // AllocateTokensToValidator allocate tokens to a particular validator,
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/andromedaprotocol/andromedad/x/distribution/types"
+)
+
+// ConsolidateCommunityFunds sweeps any balance sitting in the distribution
+// module account itself beyond what is already tracked by the fee pool and
+// outstanding validator rewards (e.g. coins sent directly to the module
+// account outside of AllocateTokens) into FeePool.CommunityPool. It
+// deliberately never touches the RewardsDripper module account: that
+// balance is the intentional future-drip reserve, not dust, and draining it
+// here would permanently break the per-block drip schedule. Sub-unit
+// dripper dust is never moved out of RewardsDripper at all (bank balances
+// are whole-unit Coins, so a sub-unit amount can't be transferred), and
+// AllocateTokens is careful not to credit CommunityPool for it, since that
+// would inflate the ledger beyond what the distribution module account
+// actually holds. It is called both at the end of AllocateTokens and from
+// CommunityPoolConsolidationProposal.
+func (k Keeper) ConsolidateCommunityFunds(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	feePool, err := k.FeePool.Get(ctx)
+	if err != nil {
+		return err
+	}
+	preBalances := feePool.CommunityPool
+
+	distrAcc := k.authKeeper.GetModuleAccount(ctx, types.ModuleName)
+	distrBalance := sdk.NewDecCoinsFromCoins(k.bankKeeper.GetAllBalances(ctx, distrAcc.GetAddress())...)
+
+	totalOutstanding, err := k.GetTotalOutstandingRewards(ctx)
+	if err != nil {
+		return err
+	}
+
+	tracked := feePool.CommunityPool.Add(totalOutstanding...)
+	if orphaned, negative := distrBalance.SafeSub(tracked); !negative && !orphaned.IsZero() {
+		feePool.CommunityPool = feePool.CommunityPool.Add(orphaned...)
+	}
+
+	if err := k.FeePool.Set(ctx, feePool); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCommunityPoolConsolidated,
+			sdk.NewAttribute(types.AttributeKeyPreBalance, preBalances.String()),
+			sdk.NewAttribute(types.AttributeKeyPostBalance, feePool.CommunityPool.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/andromedaprotocol/andromedad/x/distribution/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// RewardsSchedule implements the gRPC query returning the configured
+// per-denom RewardsDripper emission schedules.
+func (k Keeper) RewardsSchedule(ctx context.Context, _ *types.QueryRewardsScheduleRequest) (*types.QueryRewardsScheduleResponse, error) {
+	schedule, err := k.GetRewardsSchedule(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRewardsScheduleResponse{RewardsSchedule: schedule}, nil
+}
+
+// CurrentRewardsRate implements the gRPC query returning the effective
+// per-block emission rate for a given denom at the current height.
+func (k Keeper) CurrentRewardsRate(ctx context.Context, req *types.QueryCurrentRewardsRateRequest) (*types.QueryCurrentRewardsRateResponse, error) {
+	rate, err := k.currentRewardsRate(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCurrentRewardsRateResponse{RatePerBlock: rate.String()}, nil
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/andromedaprotocol/andromedad/x/distribution/types"
+)
+
+// RegisterInvariants registers all distribution invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "nonnegative-community-pool", CommunityPoolNonNegativeInvariant(k))
+}
+
+// CommunityPoolNonNegativeInvariant checks that the community pool never
+// goes negative after AllocateTokens and ConsolidateCommunityFunds run.
+// The burn-specific supply invariant lives in
+// x/feeburn, since that is the module that actually calls BurnCoins.
+func CommunityPoolNonNegativeInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		feePool, err := k.FeePool.Get(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "nonnegative-community-pool",
+				"unable to load fee pool: "+err.Error()), true
+		}
+
+		broken := feePool.CommunityPool.IsAnyNegative()
+
+		return sdk.FormatInvariant(types.ModuleName, "nonnegative-community-pool",
+			"community pool has a negative balance"), broken
+	}
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/andromedaprotocol/andromedad/x/distribution/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 adds the BaseProposerReward and BonusProposerReward params,
+// defaulting both to zero so the proposer reward split introduced alongside
+// this migration is a no-op until governance opts in.
+func (m Migrator) Migrate1to2(ctx context.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.BaseProposerReward = types.DefaultBaseProposerReward
+	params.BonusProposerReward = types.DefaultBonusProposerReward
+
+	return m.keeper.SetParams(ctx, params)
+}
+
+// Migrate2to3 adds the RewardsSchedule param, defaulting to an empty slice
+// so every denom keeps falling back to the flat RewardsPerBlock rate until
+// governance opts specific denoms into a halving schedule.
+func (m Migrator) Migrate2to3(ctx context.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.RewardsSchedule = types.RewardsSchedules{}
+
+	return m.keeper.SetParams(ctx, params)
+}
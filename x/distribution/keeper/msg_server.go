@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/andromedaprotocol/andromedad/x/distribution/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the distribution Msg
+// service that additionally supports WithdrawDelegatorRewardsAll.
+func NewMsgServerImpl(keeper Keeper) msgServer {
+	return msgServer{Keeper: keeper}
+}
+
+// WithdrawDelegatorRewardsAll withdraws rewards for every one of the
+// delegator's active delegations, and its validator commission too if
+// WithdrawCommission is set and the delegator is that validator's operator.
+// The whole operation runs against a cached context so a failure partway
+// through (e.g. one delegation's historical reference count overflowing)
+// rolls back every withdrawal already performed in this message.
+func (k msgServer) WithdrawDelegatorRewardsAll(goCtx context.Context, msg *types.MsgWithdrawDelegatorRewardsAll) (*types.MsgWithdrawDelegatorRewardsAllResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	delegations, err := k.stakingKeeper.GetDelegatorDelegations(cacheCtx, delAddr, maxWithdrawDelegations)
+	if err != nil {
+		return nil, err
+	}
+
+	// GetDelegatorDelegations has no offset/cursor, only a max-results count,
+	// so there is no way to page through the remainder within this message.
+	// Hitting the cap means some delegations would be silently skipped, so
+	// fail loudly instead and let the delegator split the withdrawal up
+	// (e.g. by withdrawing individual delegations first) rather than
+	// returning a partial result.
+	if uint16(len(delegations)) == maxWithdrawDelegations {
+		return nil, types.ErrTooManyDelegations.Wrapf(
+			"delegator %s has at least %d delegations, which exceeds what a single withdraw-all message can process",
+			msg.DelegatorAddress, maxWithdrawDelegations)
+	}
+
+	total := sdk.Coins{}
+	for _, delegation := range delegations {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(delegation.GetValidatorAddr())
+		if err != nil {
+			return nil, err
+		}
+
+		rewards, err := k.WithdrawDelegationRewards(cacheCtx, delAddr, valAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		total = total.Add(rewards...)
+	}
+
+	if msg.WithdrawCommission {
+		// The delegator and validator operator address share the same
+		// underlying bytes when a delegator is also a validator operator,
+		// so re-typing is enough to probe for a matching validator.
+		valAddr := sdk.ValAddress(delAddr)
+		if _, err := k.stakingKeeper.GetValidator(cacheCtx, valAddr); err == nil {
+			commission, err := k.WithdrawValidatorCommission(cacheCtx, valAddr)
+			if err != nil {
+				return nil, err
+			}
+			total = total.Add(commission...)
+		}
+	}
+
+	writeCache()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawAllRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, total.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+		),
+	)
+
+	return &types.MsgWithdrawDelegatorRewardsAllResponse{Amount: total.String()}, nil
+}
+
+// maxWithdrawDelegations bounds how many delegations WithdrawDelegatorRewardsAll
+// reads in one call, mirroring the pagination limits used elsewhere when
+// listing a delegator's delegations. Reaching it fails the message outright
+// (see the check in WithdrawDelegatorRewardsAll above) rather than silently
+// withdrawing only the first maxWithdrawDelegations of them, so at most
+// maxWithdrawDelegations-1 delegations are processed in one message.
+const maxWithdrawDelegations uint16 = 200
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+
+	"github.com/andromedaprotocol/andromedad/x/distribution/types"
+)
+
+// SetParams validates and sets the distribution module parameters. This is
+// the only path new proposer-reward and rewards-schedule values should be
+// written through, whether from a migration, governance, or genesis, so
+// that an invalid split (e.g. base+bonus > 1) can never reach AllocateTokens.
+func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	return k.Params.Set(ctx, params)
+}
+
+// GetBaseProposerReward returns the fraction of collected fees paid
+// unconditionally to the previous block's proposer.
+func (k Keeper) GetBaseProposerReward(ctx context.Context) (math.LegacyDec, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return params.BaseProposerReward, nil
+}
+
+// GetBonusProposerReward returns the fraction of collected fees paid to the
+// previous block's proposer in proportion to the share of voting power that
+// precommitted for that block.
+func (k Keeper) GetBonusProposerReward(ctx context.Context) (math.LegacyDec, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return params.BonusProposerReward, nil
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/andromedaprotocol/andromedad/x/distribution/types"
+)
+
+// NewCommunityPoolConsolidationProposalHandler creates a new governance
+// Handler for CommunityPoolConsolidationProposal.
+func NewCommunityPoolConsolidationProposalHandler(k Keeper) govv1beta1.Handler {
+	return func(ctx context.Context, content govv1beta1.Content) error {
+		switch c := content.(type) {
+		case *types.CommunityPoolConsolidationProposal:
+			return k.ConsolidateCommunityFunds(ctx)
+		default:
+			return fmt.Errorf("unrecognized distribution proposal content type: %T", c)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/andromedaprotocol/andromedad/x/distribution/types"
+)
+
+// GetTotalOutstandingRewards returns the sum of every validator's
+// outstanding rewards, used by ConsolidateCommunityFunds to determine how
+// much of the distribution module account's balance is actually untracked
+// dust rather than funds backing a validator's rewards.
+func (k Keeper) GetTotalOutstandingRewards(ctx context.Context) (sdk.DecCoins, error) {
+	total := sdk.DecCoins{}
+
+	err := k.IterateValidatorOutstandingRewards(ctx, func(_ sdk.ValAddress, rewards types.ValidatorOutstandingRewards) bool {
+		total = total.Add(rewards.Rewards...)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return total, nil
+}
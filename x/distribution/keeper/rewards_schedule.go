@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/andromedaprotocol/andromedad/x/distribution/types"
+)
+
+// GetRewardsSchedule returns the configured per-denom rewards schedules.
+func (k Keeper) GetRewardsSchedule(ctx context.Context) ([]types.RewardsSchedule, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return params.RewardsSchedule, nil
+}
+
+// currentRewardsRate returns the per-block emission rate that applies to
+// denom at the current block height: the matching RewardsSchedule entry's
+// halving curve if one exists, otherwise the flat RewardsPerBlock rate for
+// backward compatibility with chains that have not adopted a schedule.
+func (k Keeper) currentRewardsRate(ctx context.Context, denom string) (math.LegacyDec, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	if schedule, ok := types.RewardsSchedules(params.RewardsSchedule).ForDenom(denom); ok {
+		height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+		return schedule.CurrentPerBlock(height), nil
+	}
+
+	if params.RewardsPerBlock.IsNil() {
+		return math.LegacyZeroDec(), nil
+	}
+
+	return params.RewardsPerBlock, nil
+}
@@ -0,0 +1,19 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+// RegisterInterfaces registers the module's messages and gov Content types
+// with the interface registry, so MsgWithdrawDelegatorRewardsAll and
+// CommunityPoolConsolidationProposal can be (de)serialized off the wire.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgWithdrawDelegatorRewardsAll{},
+	)
+	registry.RegisterImplementations((*govv1beta1.Content)(nil),
+		&CommunityPoolConsolidationProposal{},
+	)
+}
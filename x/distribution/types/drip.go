@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SplitDripAmount takes the raw (un-truncated) per-denom rewards rate for
+// this block, caps it at what the dripper account actually holds, and
+// splits it into the whole-unit Coins that get transferred out of the
+// dripper and the sub-unit dust left behind by truncation. Extracted as a
+// pure function, mirroring ProposerRewardMultiplier, so AllocateTokens's
+// dripper accounting can be unit tested without a full Keeper.
+func SplitDripAmount(rawRateDec, dripperBalanceDec sdk.DecCoins) (toDripInt sdk.Coins, toDripDec, dust sdk.DecCoins) {
+	available := rawRateDec.Intersect(dripperBalanceDec)
+
+	toDripInt, _ = available.TruncateDecimal()
+	toDripDec = sdk.NewDecCoinsFromCoins(toDripInt...)
+	dust = available.Sub(toDripDec)
+
+	return toDripInt, toDripDec, dust
+}
@@ -0,0 +1,86 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/andromedaprotocol/andromedad/x/distribution/types"
+)
+
+func decCoins(t *testing.T, s string) sdk.DecCoins {
+	t.Helper()
+
+	if s == "" {
+		return sdk.NewDecCoins()
+	}
+
+	coins, err := sdk.ParseDecCoins(s)
+	require.NoError(t, err)
+
+	return coins
+}
+
+func TestSplitDripAmount(t *testing.T) {
+	testCases := []struct {
+		name              string
+		rawRate           string
+		dripperBalance    string
+		expectedToDripInt sdk.Coins
+		expectedToDripDec sdk.DecCoins
+		expectedDust      sdk.DecCoins
+	}{
+		{
+			name:              "empty dripper balance drips nothing",
+			rawRate:           "2.5stake",
+			dripperBalance:    "",
+			expectedToDripInt: sdk.NewCoins(),
+			expectedToDripDec: sdk.NewDecCoins(),
+			expectedDust:      sdk.NewDecCoins(),
+		},
+		{
+			name:              "fractional rate truncates and leaves dust",
+			rawRate:           "2.5stake",
+			dripperBalance:    "1000stake",
+			expectedToDripInt: sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(2))),
+			expectedToDripDec: decCoins(t, "2stake"),
+			expectedDust:      decCoins(t, "0.5stake"),
+		},
+		{
+			name:              "multi-denom dust accumulates independently per denom",
+			rawRate:           "2.5stake,1.25uandr",
+			dripperBalance:    "1000stake,1000uandr",
+			expectedToDripInt: sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(2)), sdk.NewCoin("uandr", math.NewInt(1))),
+			expectedToDripDec: decCoins(t, "2stake,1uandr"),
+			expectedDust:      decCoins(t, "0.5stake,0.25uandr"),
+		},
+		{
+			name:              "only a non-native denom is present in the dripper",
+			rawRate:           "3.75uandr",
+			dripperBalance:    "1000uandr",
+			expectedToDripInt: sdk.NewCoins(sdk.NewCoin("uandr", math.NewInt(3))),
+			expectedToDripDec: decCoins(t, "3uandr"),
+			expectedDust:      decCoins(t, "0.75uandr"),
+		},
+		{
+			name:              "rate exceeding the dripper balance is capped, not dusted beyond what's held",
+			rawRate:           "5stake",
+			dripperBalance:    "2stake",
+			expectedToDripInt: sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(2))),
+			expectedToDripDec: decCoins(t, "2stake"),
+			expectedDust:      sdk.NewDecCoins(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			toDripInt, toDripDec, dust := types.SplitDripAmount(decCoins(t, tc.rawRate), decCoins(t, tc.dripperBalance))
+
+			require.True(t, tc.expectedToDripInt.Equal(toDripInt), "toDripInt: expected %s, got %s", tc.expectedToDripInt, toDripInt)
+			require.True(t, tc.expectedToDripDec.Equal(toDripDec), "toDripDec: expected %s, got %s", tc.expectedToDripDec, toDripDec)
+			require.True(t, tc.expectedDust.Equal(dust), "dust: expected %s, got %s", tc.expectedDust, dust)
+		})
+	}
+}
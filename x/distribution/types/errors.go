@@ -0,0 +1,13 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/distribution module sentinel errors.
+var (
+	ErrInvalidProposerReward  = errorsmod.Register(ModuleName, 2, "invalid proposer reward params")
+	ErrInvalidRewardsSchedule = errorsmod.Register(ModuleName, 3, "invalid rewards schedule")
+	ErrInvalidDelegator       = errorsmod.Register(ModuleName, 4, "invalid delegator address")
+	ErrTooManyDelegations     = errorsmod.Register(ModuleName, 5, "delegator has too many delegations to withdraw in a single message")
+)
@@ -0,0 +1,17 @@
+package types
+
+// Additional distribution module event types and attribute keys used by the
+// community-pool consolidation flow, the withdraw-all-rewards message and the
+// rewards-drip dust report in AllocateTokens; the commission/rewards event types
+// referenced elsewhere in this module are defined alongside the rest of the
+// F1 distribution events.
+const (
+	EventTypeCommunityPoolConsolidated = "community_pool_consolidated"
+	EventTypeWithdrawAllRewards        = "withdraw_all_rewards"
+	EventTypeRewardsDripDust           = "rewards_drip_dust"
+
+	AttributeKeyPreBalance  = "pre_balance"
+	AttributeKeyPostBalance = "post_balance"
+	AttributeKeyDelegator   = "delegator"
+	AttributeKeyDust        = "dust"
+)
@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeBurnKeeper defines the expected x/feeburn keeper used by AllocateTokens
+// to burn a configurable fraction of collected fees before they are
+// allocated to validators and the community pool.
+type FeeBurnKeeper interface {
+	BurnFees(ctx sdk.Context, fromModule string, coins sdk.Coins) (burned, remaining sdk.Coins, err error)
+}
@@ -0,0 +1,5 @@
+package types
+
+// RouterKey is the message route for the distribution module, used to route
+// gov Content proposals such as CommunityPoolConsolidationProposal.
+const RouterKey = ModuleName
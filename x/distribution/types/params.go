@@ -0,0 +1,76 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+)
+
+// Default parameter values for the proposer reward split. Both default to
+// zero so that, absent an explicit governance/migration change, the F1
+// allocation in AllocateTokens is unaffected.
+var (
+	DefaultBaseProposerReward  = math.LegacyZeroDec()
+	DefaultBonusProposerReward = math.LegacyZeroDec()
+)
+
+// Params defines the parameters for the distribution module, including the
+// legacy proposer-reward split that AllocateTokens applies on top of the F1
+// proportional allocation.
+type Params struct {
+	CommunityTax        math.LegacyDec `json:"community_tax"`
+	WithdrawAddrEnabled bool           `json:"withdraw_addr_enabled"`
+	RewardsPerBlock     math.LegacyDec `json:"rewards_per_block"`
+	BaseProposerReward  math.LegacyDec `json:"base_proposer_reward"`
+	BonusProposerReward math.LegacyDec `json:"bonus_proposer_reward"`
+	// RewardsSchedule optionally overrides RewardsPerBlock on a per-denom
+	// basis with a halving emission curve. A denom dripped from
+	// RewardsDripper that has no matching entry here falls back to the
+	// flat RewardsPerBlock rate for backward compatibility.
+	RewardsSchedule RewardsSchedules `json:"rewards_schedule"`
+}
+
+// NewParams creates a new Params instance.
+func NewParams(communityTax, rewardsPerBlock, baseProposerReward, bonusProposerReward math.LegacyDec, withdrawAddrEnabled bool, rewardsSchedule RewardsSchedules) Params {
+	return Params{
+		CommunityTax:        communityTax,
+		WithdrawAddrEnabled: withdrawAddrEnabled,
+		RewardsPerBlock:     rewardsPerBlock,
+		BaseProposerReward:  baseProposerReward,
+		BonusProposerReward: bonusProposerReward,
+		RewardsSchedule:     rewardsSchedule,
+	}
+}
+
+// Validate checks that the full parameter set is internally consistent,
+// including the proposer reward split and every configured rewards
+// schedule entry.
+func (p Params) Validate() error {
+	if err := ValidateProposerRewardParams(p.BaseProposerReward, p.BonusProposerReward); err != nil {
+		return err
+	}
+
+	for _, schedule := range p.RewardsSchedule {
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateProposerRewardParams checks that the base and bonus proposer
+// reward fractions are non-negative and that their sum never exceeds one,
+// since together with the community tax they partition fees collected in a
+// block.
+func ValidateProposerRewardParams(base, bonus math.LegacyDec) error {
+	if base.IsNil() || base.IsNegative() {
+		return ErrInvalidProposerReward.Wrap("base proposer reward must be non-negative")
+	}
+	if bonus.IsNil() || bonus.IsNegative() {
+		return ErrInvalidProposerReward.Wrap("bonus proposer reward must be non-negative")
+	}
+	if base.Add(bonus).GT(math.LegacyOneDec()) {
+		return ErrInvalidProposerReward.Wrap("base + bonus proposer reward must not exceed 1")
+	}
+
+	return nil
+}
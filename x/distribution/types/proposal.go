@@ -0,0 +1,125 @@
+package types
+
+import (
+	"fmt"
+
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	proto "github.com/cosmos/gogoproto/proto"
+)
+
+const (
+	// ProposalTypeCommunityPoolConsolidation defines the type for a
+	// CommunityPoolConsolidationProposal.
+	ProposalTypeCommunityPoolConsolidation = "CommunityPoolConsolidation"
+)
+
+func init() {
+	proto.RegisterType((*CommunityPoolConsolidationProposal)(nil), "andromedad.distribution.v1.CommunityPoolConsolidationProposal")
+}
+
+// Assert CommunityPoolConsolidationProposal implements govv1beta1.Content.
+var _ govv1beta1.Content = &CommunityPoolConsolidationProposal{}
+
+// CommunityPoolConsolidationProposal is a gov Content that triggers
+// ConsolidateCommunityFunds, sweeping orphaned distribution module account
+// balance into FeePool.CommunityPool. It takes no parameters; the sweep
+// itself is fully deterministic given chain state.
+type CommunityPoolConsolidationProposal struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+func (p *CommunityPoolConsolidationProposal) GetTitle() string { return p.Title }
+
+func (p *CommunityPoolConsolidationProposal) GetDescription() string { return p.Description }
+
+func (p *CommunityPoolConsolidationProposal) ProposalRoute() string { return RouterKey }
+
+func (p *CommunityPoolConsolidationProposal) ProposalType() string {
+	return ProposalTypeCommunityPoolConsolidation
+}
+
+func (p *CommunityPoolConsolidationProposal) ValidateBasic() error {
+	return govv1beta1.ValidateAbstract(p)
+}
+
+// Reset, String and ProtoMessage satisfy proto.Message, which
+// govv1beta1.Content embeds alongside the accessor methods above.
+// CommunityPoolConsolidationProposal carries no proto-generated fields, so
+// these are hand-written rather than generated.
+func (p *CommunityPoolConsolidationProposal) Reset() { *p = CommunityPoolConsolidationProposal{} }
+
+func (p *CommunityPoolConsolidationProposal) String() string {
+	return fmt.Sprintf("CommunityPoolConsolidationProposal{Title: %q, Description: %q}", p.Title, p.Description)
+}
+
+func (*CommunityPoolConsolidationProposal) ProtoMessage() {}
+
+// Size returns the wire size of the message: field 1 is Title (string),
+// field 2 is Description (string).
+func (p *CommunityPoolConsolidationProposal) Size() (n int) {
+	if l := len(p.Title); l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	if l := len(p.Description); l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	return n
+}
+
+// Marshal implements the proto.Marshaler fast path.
+func (p *CommunityPoolConsolidationProposal) Marshal() ([]byte, error) {
+	dAtA := make([]byte, 0, p.Size())
+
+	if l := len(p.Title); l > 0 {
+		dAtA = append(dAtA, 0xa)
+		dAtA = appendVarint(dAtA, uint64(l))
+		dAtA = append(dAtA, p.Title...)
+	}
+	if l := len(p.Description); l > 0 {
+		dAtA = append(dAtA, 0x12)
+		dAtA = appendVarint(dAtA, uint64(l))
+		dAtA = append(dAtA, p.Description...)
+	}
+
+	return dAtA, nil
+}
+
+// Unmarshal implements the proto.Marshaler fast path.
+func (p *CommunityPoolConsolidationProposal) Unmarshal(dAtA []byte) error {
+	for iNdEx := 0; iNdEx < len(dAtA); {
+		tag, n, err := decodeVarint(dAtA[iNdEx:])
+		if err != nil {
+			return err
+		}
+		iNdEx += n
+
+		fieldNum, wireType := tag>>3, tag&0x7
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType %d for field Title", wireType)
+			}
+			value, n, err := decodeLengthDelimited(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			p.Title = string(value)
+			iNdEx += n
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType %d for field Description", wireType)
+			}
+			value, n, err := decodeLengthDelimited(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			p.Description = string(value)
+			iNdEx += n
+		default:
+			return fmt.Errorf("proto: unknown field number %d", fieldNum)
+		}
+	}
+
+	return nil
+}
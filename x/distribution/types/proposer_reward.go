@@ -0,0 +1,16 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+)
+
+// ProposerRewardMultiplier computes the fraction of fees paid to the
+// previous block's proposer: base + bonus * fractionVotes, where
+// fractionVotes is sumPrecommitPower / totalPreviousPower. Extracted as a
+// pure function so AllocateTokens's proposer-reward math can be unit
+// tested without a full Keeper.
+func ProposerRewardMultiplier(base, bonus math.LegacyDec, sumPrecommitPower, totalPreviousPower int64) math.LegacyDec {
+	fractionVotes := math.LegacyNewDec(sumPrecommitPower).QuoTruncate(math.LegacyNewDec(totalPreviousPower))
+
+	return base.Add(bonus.MulTruncate(fractionVotes))
+}
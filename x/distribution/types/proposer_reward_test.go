@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/andromedaprotocol/andromedad/x/distribution/types"
+)
+
+func TestProposerRewardMultiplier(t *testing.T) {
+	base := math.LegacyNewDecWithPrec(1, 2)  // 0.01
+	bonus := math.LegacyNewDecWithPrec(4, 2) // 0.04
+
+	testCases := []struct {
+		name              string
+		base, bonus       math.LegacyDec
+		sumPrecommitPower int64
+		totalPower        int64
+		expected          math.LegacyDec
+	}{
+		{
+			name:              "zero precommit power pays only the base reward",
+			base:              base,
+			bonus:             bonus,
+			sumPrecommitPower: 0,
+			totalPower:        100,
+			expected:          base,
+		},
+		{
+			name:              "zero bonus ignores precommit power entirely",
+			base:              base,
+			bonus:             math.LegacyZeroDec(),
+			sumPrecommitPower: 100,
+			totalPower:        100,
+			expected:          base,
+		},
+		{
+			name:              "full precommit power pays base plus the full bonus",
+			base:              base,
+			bonus:             bonus,
+			sumPrecommitPower: 100,
+			totalPower:        100,
+			expected:          base.Add(bonus),
+		},
+		{
+			name:              "half precommit power pays base plus half the bonus",
+			base:              base,
+			bonus:             bonus,
+			sumPrecommitPower: 50,
+			totalPower:        100,
+			expected:          base.Add(bonus.QuoInt64(2)),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := types.ProposerRewardMultiplier(tc.base, tc.bonus, tc.sumPrecommitPower, tc.totalPower)
+			require.True(t, tc.expected.Equal(got), "expected %s, got %s", tc.expected, got)
+		})
+	}
+}
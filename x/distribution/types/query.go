@@ -0,0 +1,282 @@
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+
+	grpc "google.golang.org/grpc"
+)
+
+// QueryRewardsScheduleRequest is the request type for the
+// Query/RewardsSchedule gRPC method.
+type QueryRewardsScheduleRequest struct{}
+
+// QueryRewardsScheduleResponse is the response type for the
+// Query/RewardsSchedule gRPC method.
+type QueryRewardsScheduleResponse struct {
+	RewardsSchedule []RewardsSchedule `json:"rewards_schedule"`
+}
+
+// QueryCurrentRewardsRateRequest is the request type for the
+// Query/CurrentRewardsRate gRPC method.
+type QueryCurrentRewardsRateRequest struct {
+	Denom string `json:"denom"`
+}
+
+// QueryCurrentRewardsRateResponse is the response type for the
+// Query/CurrentRewardsRate gRPC method.
+type QueryCurrentRewardsRateResponse struct {
+	RatePerBlock string `json:"rate_per_block"`
+}
+
+// QueryServer is the server API for the distribution module's Query
+// service, implemented by Keeper.
+type QueryServer interface {
+	RewardsSchedule(context.Context, *QueryRewardsScheduleRequest) (*QueryRewardsScheduleResponse, error)
+	CurrentRewardsRate(context.Context, *QueryCurrentRewardsRateRequest) (*QueryCurrentRewardsRateResponse, error)
+}
+
+// RegisterQueryServer registers srv with s under the distribution module's
+// Query service, the way a generated query.pb.go's RegisterQueryServer
+// would, so RewardsSchedule and CurrentRewardsRate are actually reachable
+// over gRPC (and, via the gRPC gateway, the CLI).
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "andromedad.distribution.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RewardsSchedule",
+			Handler:    _Query_RewardsSchedule_Handler,
+		},
+		{
+			MethodName: "CurrentRewardsRate",
+			Handler:    _Query_CurrentRewardsRate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "andromedad/distribution/v1/query.proto",
+}
+
+func _Query_RewardsSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardsScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardsSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/andromedad.distribution.v1.Query/RewardsSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardsSchedule(ctx, req.(*QueryRewardsScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CurrentRewardsRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCurrentRewardsRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CurrentRewardsRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/andromedad.distribution.v1.Query/CurrentRewardsRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CurrentRewardsRate(ctx, req.(*QueryCurrentRewardsRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Reset, String and ProtoMessage satisfy proto.Message for each query
+// request/response type, which the gRPC codec requires to (de)serialize
+// them. None of these carry proto-generated fields, so these are
+// hand-written rather than generated.
+func (m *QueryRewardsScheduleRequest) Reset()         { *m = QueryRewardsScheduleRequest{} }
+func (m *QueryRewardsScheduleRequest) String() string { return "QueryRewardsScheduleRequest{}" }
+func (*QueryRewardsScheduleRequest) ProtoMessage()    {}
+func (m *QueryRewardsScheduleRequest) Size() int      { return 0 }
+func (m *QueryRewardsScheduleRequest) Marshal() ([]byte, error) {
+	return []byte{}, nil
+}
+func (m *QueryRewardsScheduleRequest) Unmarshal(dAtA []byte) error { return nil }
+
+func (m *QueryRewardsScheduleResponse) Reset() { *m = QueryRewardsScheduleResponse{} }
+func (m *QueryRewardsScheduleResponse) String() string {
+	return fmt.Sprintf("QueryRewardsScheduleResponse{RewardsSchedule: %v}", m.RewardsSchedule)
+}
+func (*QueryRewardsScheduleResponse) ProtoMessage() {}
+
+// Size, Marshal and Unmarshal encode RewardsSchedule as a repeated
+// length-delimited field 1, the same shape protoc-gen-gogo emits for a
+// `repeated RewardsSchedule` field.
+func (m *QueryRewardsScheduleResponse) Size() (n int) {
+	for _, s := range m.RewardsSchedule {
+		l := s.Size()
+		n += 1 + l + sovWire(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardsScheduleResponse) Marshal() ([]byte, error) {
+	dAtA := make([]byte, 0, m.Size())
+
+	for _, s := range m.RewardsSchedule {
+		bz, err := s.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		dAtA = append(dAtA, 0xa)
+		dAtA = appendVarint(dAtA, uint64(len(bz)))
+		dAtA = append(dAtA, bz...)
+	}
+
+	return dAtA, nil
+}
+
+func (m *QueryRewardsScheduleResponse) Unmarshal(dAtA []byte) error {
+	for iNdEx := 0; iNdEx < len(dAtA); {
+		tag, n, err := decodeVarint(dAtA[iNdEx:])
+		if err != nil {
+			return err
+		}
+		iNdEx += n
+
+		fieldNum, wireType := tag>>3, tag&0x7
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType %d for field RewardsSchedule", wireType)
+			}
+			value, n, err := decodeLengthDelimited(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			var s RewardsSchedule
+			if err := s.Unmarshal(value); err != nil {
+				return err
+			}
+			m.RewardsSchedule = append(m.RewardsSchedule, s)
+			iNdEx += n
+		default:
+			return fmt.Errorf("proto: unknown field number %d", fieldNum)
+		}
+	}
+
+	return nil
+}
+
+func (m *QueryCurrentRewardsRateRequest) Reset() { *m = QueryCurrentRewardsRateRequest{} }
+func (m *QueryCurrentRewardsRateRequest) String() string {
+	return fmt.Sprintf("QueryCurrentRewardsRateRequest{Denom: %q}", m.Denom)
+}
+func (*QueryCurrentRewardsRateRequest) ProtoMessage() {}
+
+func (m *QueryCurrentRewardsRateRequest) Size() (n int) {
+	if l := len(m.Denom); l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCurrentRewardsRateRequest) Marshal() ([]byte, error) {
+	dAtA := make([]byte, 0, m.Size())
+
+	if l := len(m.Denom); l > 0 {
+		dAtA = append(dAtA, 0xa)
+		dAtA = appendVarint(dAtA, uint64(l))
+		dAtA = append(dAtA, m.Denom...)
+	}
+
+	return dAtA, nil
+}
+
+func (m *QueryCurrentRewardsRateRequest) Unmarshal(dAtA []byte) error {
+	for iNdEx := 0; iNdEx < len(dAtA); {
+		tag, n, err := decodeVarint(dAtA[iNdEx:])
+		if err != nil {
+			return err
+		}
+		iNdEx += n
+
+		fieldNum, wireType := tag>>3, tag&0x7
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType %d for field Denom", wireType)
+			}
+			value, n, err := decodeLengthDelimited(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			m.Denom = string(value)
+			iNdEx += n
+		default:
+			return fmt.Errorf("proto: unknown field number %d", fieldNum)
+		}
+	}
+
+	return nil
+}
+
+func (m *QueryCurrentRewardsRateResponse) Reset() { *m = QueryCurrentRewardsRateResponse{} }
+func (m *QueryCurrentRewardsRateResponse) String() string {
+	return fmt.Sprintf("QueryCurrentRewardsRateResponse{RatePerBlock: %q}", m.RatePerBlock)
+}
+func (*QueryCurrentRewardsRateResponse) ProtoMessage() {}
+
+func (m *QueryCurrentRewardsRateResponse) Size() (n int) {
+	if l := len(m.RatePerBlock); l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCurrentRewardsRateResponse) Marshal() ([]byte, error) {
+	dAtA := make([]byte, 0, m.Size())
+
+	if l := len(m.RatePerBlock); l > 0 {
+		dAtA = append(dAtA, 0xa)
+		dAtA = appendVarint(dAtA, uint64(l))
+		dAtA = append(dAtA, m.RatePerBlock...)
+	}
+
+	return dAtA, nil
+}
+
+func (m *QueryCurrentRewardsRateResponse) Unmarshal(dAtA []byte) error {
+	for iNdEx := 0; iNdEx < len(dAtA); {
+		tag, n, err := decodeVarint(dAtA[iNdEx:])
+		if err != nil {
+			return err
+		}
+		iNdEx += n
+
+		fieldNum, wireType := tag>>3, tag&0x7
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType %d for field RatePerBlock", wireType)
+			}
+			value, n, err := decodeLengthDelimited(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			m.RatePerBlock = string(value)
+			iNdEx += n
+		default:
+			return fmt.Errorf("proto: unknown field number %d", fieldNum)
+		}
+	}
+
+	return nil
+}
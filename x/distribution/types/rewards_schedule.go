@@ -0,0 +1,216 @@
+package types
+
+import (
+	fmt "fmt"
+
+	"cosmossdk.io/math"
+)
+
+// RewardsSchedule defines a per-denom emission schedule for the
+// RewardsDripper: InitialPerBlock halves every HalvingIntervalBlocks blocks
+// starting at StartHeight, floored at MinPerBlock.
+type RewardsSchedule struct {
+	Denom                 string         `json:"denom"`
+	InitialPerBlock       math.LegacyDec `json:"initial_per_block"`
+	HalvingIntervalBlocks uint64         `json:"halving_interval_blocks"`
+	MinPerBlock           math.LegacyDec `json:"min_per_block"`
+	StartHeight           int64          `json:"start_height"`
+}
+
+// Validate checks that a RewardsSchedule entry is well-formed.
+func (s RewardsSchedule) Validate() error {
+	if s.Denom == "" {
+		return ErrInvalidRewardsSchedule.Wrap("denom cannot be empty")
+	}
+	if s.InitialPerBlock.IsNil() || s.InitialPerBlock.IsNegative() {
+		return ErrInvalidRewardsSchedule.Wrapf("%s: initial per block must be non-negative", s.Denom)
+	}
+	if s.MinPerBlock.IsNil() || s.MinPerBlock.IsNegative() {
+		return ErrInvalidRewardsSchedule.Wrapf("%s: min per block must be non-negative", s.Denom)
+	}
+	if s.MinPerBlock.GT(s.InitialPerBlock) {
+		return ErrInvalidRewardsSchedule.Wrapf("%s: min per block must not exceed initial per block", s.Denom)
+	}
+	if s.HalvingIntervalBlocks == 0 {
+		return ErrInvalidRewardsSchedule.Wrapf("%s: halving interval blocks must be positive", s.Denom)
+	}
+	if s.StartHeight < 0 {
+		return ErrInvalidRewardsSchedule.Wrapf("%s: start height cannot be negative", s.Denom)
+	}
+
+	return nil
+}
+
+// CurrentPerBlock returns the emission rate for this schedule at the given
+// height: InitialPerBlock halved once per HalvingIntervalBlocks elapsed
+// since StartHeight, floored at MinPerBlock. Heights before StartHeight
+// yield zero, since the schedule has not started dripping yet.
+func (s RewardsSchedule) CurrentPerBlock(height int64) math.LegacyDec {
+	if height < s.StartHeight {
+		return math.LegacyZeroDec()
+	}
+
+	halvings := uint64(height-s.StartHeight) / s.HalvingIntervalBlocks
+
+	current := s.InitialPerBlock
+	for i := uint64(0); i < halvings && current.GT(s.MinPerBlock); i++ {
+		current = current.QuoInt64(2)
+	}
+
+	if current.LT(s.MinPerBlock) {
+		current = s.MinPerBlock
+	}
+
+	return current
+}
+
+// Size returns the wire size of RewardsSchedule: field 1 is Denom (string),
+// field 2 is InitialPerBlock (Dec, length-delimited), field 3 is
+// HalvingIntervalBlocks (uint64, varint), field 4 is MinPerBlock (Dec,
+// length-delimited), field 5 is StartHeight (int64, varint).
+func (s RewardsSchedule) Size() (n int) {
+	if l := len(s.Denom); l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	if !s.InitialPerBlock.IsNil() {
+		l := s.InitialPerBlock.Size()
+		n += 1 + l + sovWire(uint64(l))
+	}
+	if s.HalvingIntervalBlocks != 0 {
+		n += 1 + sovWire(s.HalvingIntervalBlocks)
+	}
+	if !s.MinPerBlock.IsNil() {
+		l := s.MinPerBlock.Size()
+		n += 1 + l + sovWire(uint64(l))
+	}
+	if s.StartHeight != 0 {
+		n += 1 + sovWire(uint64(s.StartHeight))
+	}
+	return n
+}
+
+// Marshal implements the proto.Marshaler fast path.
+func (s RewardsSchedule) Marshal() ([]byte, error) {
+	dAtA := make([]byte, 0, s.Size())
+
+	if l := len(s.Denom); l > 0 {
+		dAtA = append(dAtA, 0xa)
+		dAtA = appendVarint(dAtA, uint64(l))
+		dAtA = append(dAtA, s.Denom...)
+	}
+	if !s.InitialPerBlock.IsNil() {
+		bz, err := s.InitialPerBlock.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		dAtA = append(dAtA, 0x12)
+		dAtA = appendVarint(dAtA, uint64(len(bz)))
+		dAtA = append(dAtA, bz...)
+	}
+	if s.HalvingIntervalBlocks != 0 {
+		dAtA = append(dAtA, 0x18)
+		dAtA = appendVarint(dAtA, s.HalvingIntervalBlocks)
+	}
+	if !s.MinPerBlock.IsNil() {
+		bz, err := s.MinPerBlock.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		dAtA = append(dAtA, 0x22)
+		dAtA = appendVarint(dAtA, uint64(len(bz)))
+		dAtA = append(dAtA, bz...)
+	}
+	if s.StartHeight != 0 {
+		dAtA = append(dAtA, 0x28)
+		dAtA = appendVarint(dAtA, uint64(s.StartHeight))
+	}
+
+	return dAtA, nil
+}
+
+// Unmarshal implements the proto.Marshaler fast path.
+func (s *RewardsSchedule) Unmarshal(dAtA []byte) error {
+	for iNdEx := 0; iNdEx < len(dAtA); {
+		tag, n, err := decodeVarint(dAtA[iNdEx:])
+		if err != nil {
+			return err
+		}
+		iNdEx += n
+
+		fieldNum, wireType := tag>>3, tag&0x7
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType %d for field Denom", wireType)
+			}
+			value, n, err := decodeLengthDelimited(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			s.Denom = string(value)
+			iNdEx += n
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType %d for field InitialPerBlock", wireType)
+			}
+			value, n, err := decodeLengthDelimited(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if err := s.InitialPerBlock.Unmarshal(value); err != nil {
+				return err
+			}
+			iNdEx += n
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType %d for field HalvingIntervalBlocks", wireType)
+			}
+			value, n, err := decodeVarint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			s.HalvingIntervalBlocks = value
+			iNdEx += n
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType %d for field MinPerBlock", wireType)
+			}
+			value, n, err := decodeLengthDelimited(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if err := s.MinPerBlock.Unmarshal(value); err != nil {
+				return err
+			}
+			iNdEx += n
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType %d for field StartHeight", wireType)
+			}
+			value, n, err := decodeVarint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			s.StartHeight = int64(value)
+			iNdEx += n
+		default:
+			return fmt.Errorf("proto: unknown field number %d", fieldNum)
+		}
+	}
+
+	return nil
+}
+
+// RewardsSchedules is a slice of RewardsSchedule with a lookup helper.
+type RewardsSchedules []RewardsSchedule
+
+// ForDenom returns the schedule entry for denom, if any.
+func (s RewardsSchedules) ForDenom(denom string) (RewardsSchedule, bool) {
+	for _, schedule := range s {
+		if schedule.Denom == denom {
+			return schedule, true
+		}
+	}
+
+	return RewardsSchedule{}, false
+}
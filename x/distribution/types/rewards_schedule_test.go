@@ -0,0 +1,78 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/andromedaprotocol/andromedad/x/distribution/types"
+)
+
+func TestRewardsScheduleCurrentPerBlock(t *testing.T) {
+	schedule := types.RewardsSchedule{
+		Denom:                 "stake",
+		InitialPerBlock:       math.LegacyNewDec(100),
+		HalvingIntervalBlocks: 1000,
+		MinPerBlock:           math.LegacyNewDec(1),
+		StartHeight:           500,
+	}
+
+	testCases := []struct {
+		name     string
+		height   int64
+		expected math.LegacyDec
+	}{
+		{
+			name:     "before start height yields zero",
+			height:   499,
+			expected: math.LegacyZeroDec(),
+		},
+		{
+			name:     "at start height yields the initial rate",
+			height:   500,
+			expected: math.LegacyNewDec(100),
+		},
+		{
+			name:     "one block before the first halving still yields the initial rate",
+			height:   1499,
+			expected: math.LegacyNewDec(100),
+		},
+		{
+			name:     "exactly at the first halving boundary yields half",
+			height:   1500,
+			expected: math.LegacyNewDec(50),
+		},
+		{
+			name:     "exactly at the second halving boundary yields a quarter",
+			height:   2500,
+			expected: math.LegacyNewDec(25),
+		},
+		{
+			name:     "enough halvings to fall below the floor clamps at MinPerBlock",
+			height:   500 + 1000*10,
+			expected: math.LegacyNewDec(1),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := schedule.CurrentPerBlock(tc.height)
+			require.True(t, tc.expected.Equal(got), "expected %s, got %s", tc.expected, got)
+		})
+	}
+}
+
+func TestRewardsSchedulesForDenom(t *testing.T) {
+	schedules := types.RewardsSchedules{
+		{Denom: "stake", InitialPerBlock: math.LegacyNewDec(100), HalvingIntervalBlocks: 1000, MinPerBlock: math.LegacyNewDec(1)},
+		{Denom: "uandr", InitialPerBlock: math.LegacyNewDec(50), HalvingIntervalBlocks: 2000, MinPerBlock: math.LegacyNewDec(1)},
+	}
+
+	found, ok := schedules.ForDenom("uandr")
+	require.True(t, ok)
+	require.Equal(t, "uandr", found.Denom)
+
+	_, ok = schedules.ForDenom("missing")
+	require.False(t, ok)
+}
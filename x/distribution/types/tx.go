@@ -0,0 +1,195 @@
+package types
+
+import (
+	fmt "fmt"
+
+	proto "github.com/cosmos/gogoproto/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func init() {
+	proto.RegisterType((*MsgWithdrawDelegatorRewardsAll)(nil), "andromedad.distribution.v1.MsgWithdrawDelegatorRewardsAll")
+	proto.RegisterType((*MsgWithdrawDelegatorRewardsAllResponse)(nil), "andromedad.distribution.v1.MsgWithdrawDelegatorRewardsAllResponse")
+}
+
+// MsgWithdrawDelegatorRewardsAll withdraws rewards for every one of a
+// delegator's active delegations in a single transaction. When
+// WithdrawCommission is true and DelegatorAddress is also a validator's
+// operator address, that validator's commission is withdrawn as well.
+type MsgWithdrawDelegatorRewardsAll struct {
+	DelegatorAddress   string `json:"delegator_address"`
+	WithdrawCommission bool   `json:"withdraw_commission"`
+}
+
+// MsgWithdrawDelegatorRewardsAllResponse is the response type for
+// MsgWithdrawDelegatorRewardsAll, summing the total withdrawn per denom
+// across every delegation (and commission, if requested).
+type MsgWithdrawDelegatorRewardsAllResponse struct {
+	Amount string `json:"amount"`
+}
+
+// ValidateBasic performs basic validation of the message.
+func (m MsgWithdrawDelegatorRewardsAll) ValidateBasic() error {
+	if m.DelegatorAddress == "" {
+		return ErrInvalidDelegator.Wrap("delegator address cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.DelegatorAddress); err != nil {
+		return ErrInvalidDelegator.Wrapf("invalid delegator address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgWithdrawDelegatorRewardsAll.
+func (m *MsgWithdrawDelegatorRewardsAll) GetSigners() []sdk.AccAddress {
+	delAddr, err := sdk.AccAddressFromBech32(m.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{delAddr}
+}
+
+// Reset, String and ProtoMessage satisfy proto.Message, which sdk.Msg
+// embeds. MsgWithdrawDelegatorRewardsAll carries no proto-generated fields,
+// so these are hand-written rather than generated.
+func (m *MsgWithdrawDelegatorRewardsAll) Reset() { *m = MsgWithdrawDelegatorRewardsAll{} }
+
+func (m *MsgWithdrawDelegatorRewardsAll) String() string {
+	return fmt.Sprintf("MsgWithdrawDelegatorRewardsAll{DelegatorAddress: %q, WithdrawCommission: %t}", m.DelegatorAddress, m.WithdrawCommission)
+}
+
+func (*MsgWithdrawDelegatorRewardsAll) ProtoMessage() {}
+
+// Reset, String and ProtoMessage for the response type, for the same reason.
+func (m *MsgWithdrawDelegatorRewardsAllResponse) Reset() {
+	*m = MsgWithdrawDelegatorRewardsAllResponse{}
+}
+
+func (m *MsgWithdrawDelegatorRewardsAllResponse) String() string {
+	return fmt.Sprintf("MsgWithdrawDelegatorRewardsAllResponse{Amount: %q}", m.Amount)
+}
+
+func (*MsgWithdrawDelegatorRewardsAllResponse) ProtoMessage() {}
+
+// Size returns the wire size of the message, matching the field layout
+// Marshal/Unmarshal use below: field 1 is DelegatorAddress (string), field 2
+// is WithdrawCommission (bool).
+func (m *MsgWithdrawDelegatorRewardsAll) Size() (n int) {
+	if l := len(m.DelegatorAddress); l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	if m.WithdrawCommission {
+		n += 2
+	}
+	return n
+}
+
+// Marshal implements the proto.Marshaler fast path.
+func (m *MsgWithdrawDelegatorRewardsAll) Marshal() ([]byte, error) {
+	dAtA := make([]byte, 0, m.Size())
+
+	if l := len(m.DelegatorAddress); l > 0 {
+		dAtA = append(dAtA, 0xa)
+		dAtA = appendVarint(dAtA, uint64(l))
+		dAtA = append(dAtA, m.DelegatorAddress...)
+	}
+	if m.WithdrawCommission {
+		dAtA = append(dAtA, 0x10)
+		dAtA = appendVarint(dAtA, 1)
+	}
+
+	return dAtA, nil
+}
+
+// Unmarshal implements the proto.Marshaler fast path.
+func (m *MsgWithdrawDelegatorRewardsAll) Unmarshal(dAtA []byte) error {
+	for iNdEx := 0; iNdEx < len(dAtA); {
+		tag, n, err := decodeVarint(dAtA[iNdEx:])
+		if err != nil {
+			return err
+		}
+		iNdEx += n
+
+		fieldNum, wireType := tag>>3, tag&0x7
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType %d for field DelegatorAddress", wireType)
+			}
+			value, n, err := decodeLengthDelimited(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			m.DelegatorAddress = string(value)
+			iNdEx += n
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType %d for field WithdrawCommission", wireType)
+			}
+			value, n, err := decodeVarint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			m.WithdrawCommission = value != 0
+			iNdEx += n
+		default:
+			return fmt.Errorf("proto: unknown field number %d", fieldNum)
+		}
+	}
+
+	return nil
+}
+
+// Size returns the wire size of the message, matching the field layout
+// Marshal/Unmarshal use below: field 1 is Amount (string).
+func (m *MsgWithdrawDelegatorRewardsAllResponse) Size() (n int) {
+	if l := len(m.Amount); l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	return n
+}
+
+// Marshal implements the proto.Marshaler fast path.
+func (m *MsgWithdrawDelegatorRewardsAllResponse) Marshal() ([]byte, error) {
+	dAtA := make([]byte, 0, m.Size())
+
+	if l := len(m.Amount); l > 0 {
+		dAtA = append(dAtA, 0xa)
+		dAtA = appendVarint(dAtA, uint64(l))
+		dAtA = append(dAtA, m.Amount...)
+	}
+
+	return dAtA, nil
+}
+
+// Unmarshal implements the proto.Marshaler fast path.
+func (m *MsgWithdrawDelegatorRewardsAllResponse) Unmarshal(dAtA []byte) error {
+	for iNdEx := 0; iNdEx < len(dAtA); {
+		tag, n, err := decodeVarint(dAtA[iNdEx:])
+		if err != nil {
+			return err
+		}
+		iNdEx += n
+
+		fieldNum, wireType := tag>>3, tag&0x7
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType %d for field Amount", wireType)
+			}
+			value, n, err := decodeLengthDelimited(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			m.Amount = string(value)
+			iNdEx += n
+		default:
+			return fmt.Errorf("proto: unknown field number %d", fieldNum)
+		}
+	}
+
+	return nil
+}
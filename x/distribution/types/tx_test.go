@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/andromedaprotocol/andromedad/x/distribution/types"
+)
+
+func TestMsgWithdrawDelegatorRewardsAllValidateBasic(t *testing.T) {
+	validAddr := sdk.AccAddress([]byte("0123456789abcdefghij")).String()
+
+	testCases := []struct {
+		name    string
+		msg     types.MsgWithdrawDelegatorRewardsAll
+		wantErr bool
+	}{
+		{
+			name:    "empty delegator address is rejected",
+			msg:     types.MsgWithdrawDelegatorRewardsAll{DelegatorAddress: ""},
+			wantErr: true,
+		},
+		{
+			name:    "malformed delegator address is rejected",
+			msg:     types.MsgWithdrawDelegatorRewardsAll{DelegatorAddress: "not-a-bech32-address"},
+			wantErr: true,
+		},
+		{
+			name:    "valid delegator address without commission is accepted",
+			msg:     types.MsgWithdrawDelegatorRewardsAll{DelegatorAddress: validAddr},
+			wantErr: false,
+		},
+		{
+			name:    "valid delegator address with commission is accepted",
+			msg:     types.MsgWithdrawDelegatorRewardsAll{DelegatorAddress: validAddr, WithdrawCommission: true},
+			wantErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgWithdrawDelegatorRewardsAllGetSigners(t *testing.T) {
+	validAddr := sdk.AccAddress([]byte("0123456789abcdefghij"))
+
+	msg := types.MsgWithdrawDelegatorRewardsAll{DelegatorAddress: validAddr.String()}
+	require.Equal(t, []sdk.AccAddress{validAddr}, msg.GetSigners())
+}
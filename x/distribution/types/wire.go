@@ -0,0 +1,65 @@
+package types
+
+import (
+	fmt "fmt"
+)
+
+// Minimal hand-written protobuf wire helpers for the hand-rolled message
+// types in this package (MsgWithdrawDelegatorRewardsAll and its response,
+// CommunityPoolConsolidationProposal, RewardsSchedule and the query
+// request/response types), which have no .proto source to generate pb.go
+// Marshal/Unmarshal/Size implementations from. These mirror the subset of
+// the wire format protoc-gen-gogo would emit for their string, bool,
+// integer and Dec fields: varint-encoded tags, varint length prefixes for
+// length-delimited fields, and proto3 skip-if-zero field encoding.
+
+func sovWire(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+
+func appendVarint(dAtA []byte, v uint64) []byte {
+	for v >= 0x80 {
+		dAtA = append(dAtA, byte(v)|0x80)
+		v >>= 7
+	}
+	return append(dAtA, byte(v))
+}
+
+func decodeVarint(dAtA []byte) (uint64, int, error) {
+	var v uint64
+	var shift uint
+	for i := 0; i < len(dAtA); i++ {
+		b := dAtA[i]
+		v |= uint64(b&0x7f) << shift
+		if b < 0x80 {
+			return v, i + 1, nil
+		}
+		shift += 7
+		if shift >= 64 {
+			return 0, 0, fmt.Errorf("proto: varint overflow")
+		}
+	}
+	return 0, 0, fmt.Errorf("proto: unexpected EOF parsing varint")
+}
+
+// decodeLengthDelimited reads a varint length prefix followed by that many
+// bytes, returning the payload and the number of bytes consumed overall.
+func decodeLengthDelimited(dAtA []byte) ([]byte, int, error) {
+	strLen, n, err := decodeVarint(dAtA)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if n+int(strLen) > len(dAtA) {
+		return nil, 0, fmt.Errorf("proto: unexpected EOF reading length-delimited field")
+	}
+
+	return dAtA[n : n+int(strLen)], n + int(strLen), nil
+}
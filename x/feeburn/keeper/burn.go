@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/andromedaprotocol/andromedad/x/feeburn/types"
+)
+
+// BurnFees burns FeeBurnPercent of coins out of fromModule's own balance
+// (the x/distribution module account, in practice, since fees are moved
+// there before allocation) and returns the burned and remaining portions so
+// the caller can continue allocating what's left. Coins are split per-denom
+// with truncation, so burned+remaining always equals the input exactly.
+func (k Keeper) BurnFees(ctx sdk.Context, fromModule string, coins sdk.Coins) (burned, remaining sdk.Coins, err error) {
+	feeBurnPercent := k.GetFeeBurnPercent(ctx)
+	if feeBurnPercent.IsZero() || coins.Empty() {
+		return sdk.NewCoins(), coins, nil
+	}
+
+	burnedCoins := sdk.NewCoins()
+	for _, coin := range coins {
+		burnAmount := math.LegacyNewDecFromInt(coin.Amount).MulTruncate(feeBurnPercent).TruncateInt()
+		if burnAmount.IsPositive() {
+			burnedCoins = burnedCoins.Add(sdk.NewCoin(coin.Denom, burnAmount))
+		}
+	}
+
+	if burnedCoins.Empty() {
+		return sdk.NewCoins(), coins, nil
+	}
+
+	preSupply := make(sdk.Coins, len(burnedCoins))
+	for i, coin := range burnedCoins {
+		preSupply[i] = k.bankKeeper.GetSupply(ctx, coin.Denom)
+		if err := k.recordPreBurnSupply(ctx, preSupply[i]); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, fromModule, burnedCoins); err != nil {
+		return nil, nil, err
+	}
+
+	for i, coin := range burnedCoins {
+		postSupply := k.bankKeeper.GetSupply(ctx, coin.Denom)
+		if preSupply[i].Amount.Sub(postSupply.Amount).LT(coin.Amount) {
+			return nil, nil, types.ErrSupplyNotReduced.Wrapf(
+				"burning %s did not reduce %s supply by the expected amount", coin, coin.Denom)
+		}
+	}
+
+	if err := k.addTotalBurned(ctx, burnedCoins); err != nil {
+		return nil, nil, err
+	}
+
+	remaining = coins.Sub(burnedCoins...)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeeBurn,
+			sdk.NewAttribute(types.AttributeKeyBurnedAmount, burnedCoins.String()),
+		),
+	)
+
+	return burnedCoins, remaining, nil
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/andromedaprotocol/andromedad/x/feeburn/types"
+)
+
+// RegisterInvariants registers all feeburn invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "valid-fee-burn-percent", FeeBurnPercentInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "burned-coins-removed-from-supply", BurnedCoinsRemovedFromSupplyInvariant(k))
+}
+
+// FeeBurnPercentInvariant checks that the configured fee-burn percent
+// remains within [0, 1], since AllocateTokens relies on this bound to
+// guarantee burned coins are never double-counted toward remaining.
+func FeeBurnPercentInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		params := k.GetParams(ctx)
+
+		broken := params.FeeBurnPercent.IsNil() ||
+			params.FeeBurnPercent.IsNegative() ||
+			params.FeeBurnPercent.GT(math.LegacyOneDec())
+
+		return sdk.FormatInvariant(types.ModuleName, "valid-fee-burn-percent",
+			fmt.Sprintf("fee burn percent: %s", params.FeeBurnPercent)), broken
+	}
+}
+
+// BurnedCoinsRemovedFromSupplyInvariant checks, for every denom BurnFees has
+// ever burned, that current bank supply has fallen by at least as much as
+// TotalBurned records relative to the supply recorded just before the first
+// burn of that denom. This is a lower bound rather than an exact equality
+// because other modules may mint or burn the same denom independently; what
+// must never happen is TotalBurned coins being burned in name only while
+// supply stays flat or grows, which is exactly what BurnFees's own per-call
+// pre/post supply check (see BurnFees) is meant to prevent.
+func BurnedCoinsRemovedFromSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		totalBurned := k.GetTotalBurned(ctx)
+
+		broken := false
+		for _, coin := range totalBurned {
+			preBurnSupply := k.GetPreBurnSupply(ctx, coin.Denom)
+			currentSupply := k.bankKeeper.GetSupply(ctx, coin.Denom)
+
+			reduced := preBurnSupply.Amount.Sub(currentSupply.Amount)
+			if reduced.LT(coin.Amount) {
+				broken = true
+				break
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "burned-coins-removed-from-supply",
+			fmt.Sprintf("total burned: %s", totalBurned)), broken
+	}
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/core/store"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/andromedaprotocol/andromedad/x/feeburn/types"
+)
+
+// BankKeeper defines the expected bank keeper interface used by x/feeburn.
+type BankKeeper interface {
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
+}
+
+// Keeper of the feeburn store.
+type Keeper struct {
+	storeService storetypes.KVStoreService
+	paramSpace   paramtypes.Subspace
+	authority    string
+
+	bankKeeper BankKeeper
+}
+
+// NewKeeper creates a new feeburn Keeper.
+func NewKeeper(storeService storetypes.KVStoreService, paramSpace paramtypes.Subspace, bankKeeper BankKeeper, authority string) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeService: storeService,
+		paramSpace:   paramSpace,
+		bankKeeper:   bankKeeper,
+		authority:    authority,
+	}
+}
+
+// GetAuthority returns the address authorized to govern feeburn params.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
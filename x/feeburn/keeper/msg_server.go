@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/andromedaprotocol/andromedad/x/feeburn/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the feeburn Msg service.
+func NewMsgServerImpl(keeper Keeper) msgServer {
+	return msgServer{Keeper: keeper}
+}
+
+// UpdateParams updates the feeburn module parameters via governance.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, msg.Params); err != nil {
+		return nil, fmt.Errorf("updating feeburn params: %w", err)
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
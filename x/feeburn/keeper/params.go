@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/andromedaprotocol/andromedad/x/feeburn/types"
+)
+
+// GetParams returns the feeburn module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSpace.GetParamSet(ctx, &params)
+
+	return params
+}
+
+// SetParams sets the feeburn module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	k.paramSpace.SetParamSet(ctx, &params)
+
+	return nil
+}
+
+// GetFeeBurnPercent returns the configured fee-burn fraction.
+func (k Keeper) GetFeeBurnPercent(ctx sdk.Context) math.LegacyDec {
+	var feeBurnPercent math.LegacyDec
+	k.paramSpace.Get(ctx, types.ParamStoreKeyFeeBurnPercent, &feeBurnPercent)
+
+	return feeBurnPercent
+}
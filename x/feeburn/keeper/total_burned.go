@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	totalBurnedKey   = []byte("total_burned")
+	preBurnSupplyKey = []byte("pre_burn_supply")
+)
+
+// GetTotalBurned returns the cumulative amount of coins burned through
+// BurnFees since genesis, per denom.
+func (k Keeper) GetTotalBurned(ctx sdk.Context) sdk.Coins {
+	store := k.storeService.OpenKVStore(ctx)
+
+	bz, err := store.Get(totalBurnedKey)
+	if err != nil || bz == nil {
+		return sdk.NewCoins()
+	}
+
+	var total sdk.Coins
+	if err := json.Unmarshal(bz, &total); err != nil {
+		return sdk.NewCoins()
+	}
+
+	return total
+}
+
+// addTotalBurned records additional burned coins against the running total
+// used by BurnedCoinsRemovedFromSupplyInvariant.
+func (k Keeper) addTotalBurned(ctx sdk.Context, burned sdk.Coins) error {
+	total := k.GetTotalBurned(ctx).Add(burned...)
+
+	bz, err := json.Marshal(total)
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+
+	return store.Set(totalBurnedKey, bz)
+}
+
+// GetPreBurnSupply returns the bank supply of denom recorded the first time
+// it was ever burned through BurnFees, or the zero coin if denom has never
+// been burned. BurnedCoinsRemovedFromSupplyInvariant uses this baseline to
+// check that supply has fallen by at least as much as TotalBurned records.
+func (k Keeper) GetPreBurnSupply(ctx sdk.Context, denom string) sdk.Coin {
+	store := k.storeService.OpenKVStore(ctx)
+
+	bz, err := store.Get(preBurnSupplyKey)
+	if err != nil || bz == nil {
+		return sdk.NewCoin(denom, math.ZeroInt())
+	}
+
+	var baselines sdk.Coins
+	if err := json.Unmarshal(bz, &baselines); err != nil {
+		return sdk.NewCoin(denom, math.ZeroInt())
+	}
+
+	return sdk.NewCoin(denom, baselines.AmountOf(denom))
+}
+
+// recordPreBurnSupply stores supply as the baseline for denom, but only the
+// first time denom is burned; later calls are no-ops so the baseline always
+// reflects supply immediately before the very first burn.
+func (k Keeper) recordPreBurnSupply(ctx sdk.Context, supply sdk.Coin) error {
+	store := k.storeService.OpenKVStore(ctx)
+
+	bz, err := store.Get(preBurnSupplyKey)
+	if err != nil {
+		return err
+	}
+
+	var baselines sdk.Coins
+	if bz != nil {
+		if err := json.Unmarshal(bz, &baselines); err != nil {
+			return err
+		}
+	}
+
+	if baselines.AmountOf(supply.Denom).IsPositive() {
+		return nil
+	}
+
+	baselines = baselines.Add(supply)
+
+	bz, err = json.Marshal(baselines)
+	if err != nil {
+		return err
+	}
+
+	return store.Set(preBurnSupplyKey, bz)
+}
@@ -0,0 +1,11 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/feeburn module sentinel errors.
+var (
+	ErrInvalidFeeBurnPercent = errorsmod.Register(ModuleName, 2, "invalid fee burn percent")
+	ErrSupplyNotReduced      = errorsmod.Register(ModuleName, 3, "bank supply was not reduced by the burned amount")
+)
@@ -0,0 +1,8 @@
+package types
+
+// feeburn module event types and attribute keys.
+const (
+	EventTypeFeeBurn = "fee_burn"
+
+	AttributeKeyBurnedAmount = "burned_amount"
+)
@@ -0,0 +1,16 @@
+package types
+
+// GenesisState defines the feeburn module's genesis state.
+type GenesisState struct {
+	Params Params `json:"params"`
+}
+
+// DefaultGenesis returns the default feeburn genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{Params: DefaultParams()}
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	return gs.Params.Validate()
+}
@@ -0,0 +1,9 @@
+package types
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "feeburn"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+)
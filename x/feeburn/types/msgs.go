@@ -0,0 +1,146 @@
+package types
+
+import (
+	fmt "fmt"
+
+	proto "github.com/cosmos/gogoproto/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "andromedad.feeburn.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "andromedad.feeburn.v1.MsgUpdateParamsResponse")
+}
+
+// MsgUpdateParams defines a governance operation for updating the feeburn
+// module parameters. The authority is defined in the keeper.
+type MsgUpdateParams struct {
+	// Authority is the address that is permitted to update the params
+	// (typically the gov module account).
+	Authority string `json:"authority"`
+	Params    Params `json:"params"`
+}
+
+// MsgUpdateParamsResponse defines the response to MsgUpdateParams.
+type MsgUpdateParamsResponse struct{}
+
+// ValidateBasic performs basic validation of the message.
+func (m MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %w", err)
+	}
+
+	return m.Params.Validate()
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams, which is
+// always just the authority (the gov module account, in practice).
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{authority}
+}
+
+// Reset, String and ProtoMessage satisfy proto.Message, which sdk.Msg
+// embeds. MsgUpdateParams carries no proto-generated fields, so these are
+// hand-written rather than generated.
+func (m *MsgUpdateParams) Reset() { *m = MsgUpdateParams{} }
+
+func (m *MsgUpdateParams) String() string {
+	return fmt.Sprintf("MsgUpdateParams{Authority: %q, Params: %s}", m.Authority, m.Params.FeeBurnPercent)
+}
+
+func (*MsgUpdateParams) ProtoMessage() {}
+
+// Reset, String and ProtoMessage for the response type, for the same reason.
+func (m *MsgUpdateParamsResponse) Reset() { *m = MsgUpdateParamsResponse{} }
+
+func (m *MsgUpdateParamsResponse) String() string { return "MsgUpdateParamsResponse{}" }
+
+func (*MsgUpdateParamsResponse) ProtoMessage() {}
+
+// Size returns the wire size of the message: field 1 is Authority (string),
+// field 2 is Params (an embedded length-delimited message).
+func (m *MsgUpdateParams) Size() (n int) {
+	if l := len(m.Authority); l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	if l := m.Params.Size(); l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	return n
+}
+
+// Marshal implements the proto.Marshaler fast path.
+func (m *MsgUpdateParams) Marshal() ([]byte, error) {
+	dAtA := make([]byte, 0, m.Size())
+
+	if l := len(m.Authority); l > 0 {
+		dAtA = append(dAtA, 0xa)
+		dAtA = appendVarint(dAtA, uint64(l))
+		dAtA = append(dAtA, m.Authority...)
+	}
+	if l := m.Params.Size(); l > 0 {
+		paramsBz, err := m.Params.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		dAtA = append(dAtA, 0x12)
+		dAtA = appendVarint(dAtA, uint64(l))
+		dAtA = append(dAtA, paramsBz...)
+	}
+
+	return dAtA, nil
+}
+
+// Unmarshal implements the proto.Marshaler fast path.
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	for iNdEx := 0; iNdEx < len(dAtA); {
+		tag, n, err := decodeVarint(dAtA[iNdEx:])
+		if err != nil {
+			return err
+		}
+		iNdEx += n
+
+		fieldNum, wireType := tag>>3, tag&0x7
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType %d for field Authority", wireType)
+			}
+			value, n, err := decodeLengthDelimited(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			m.Authority = string(value)
+			iNdEx += n
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType %d for field Params", wireType)
+			}
+			value, n, err := decodeLengthDelimited(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if err := m.Params.Unmarshal(value); err != nil {
+				return err
+			}
+			iNdEx += n
+		default:
+			return fmt.Errorf("proto: unknown field number %d", fieldNum)
+		}
+	}
+
+	return nil
+}
+
+// Size, Marshal and Unmarshal for the response type: it carries no fields.
+func (m *MsgUpdateParamsResponse) Size() int { return 0 }
+
+func (m *MsgUpdateParamsResponse) Marshal() ([]byte, error) { return []byte{}, nil }
+
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error { return nil }
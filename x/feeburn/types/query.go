@@ -0,0 +1,132 @@
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+
+	grpc "google.golang.org/grpc"
+)
+
+// QueryParamsRequest is the request type for the Query/Params gRPC method.
+type QueryParamsRequest struct{}
+
+// QueryParamsResponse is the response type for the Query/Params gRPC method.
+type QueryParamsResponse struct {
+	Params Params `json:"params"`
+}
+
+// QueryServer is the server API for the feeburn module's Query service,
+// implemented by Keeper.
+type QueryServer interface {
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// RegisterQueryServer registers srv with s under the feeburn module's Query
+// service, the way a generated query.pb.go's RegisterQueryServer would, so
+// Params is actually reachable over gRPC (and, via the gRPC gateway, the
+// CLI).
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "andromedad.feeburn.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "andromedad/feeburn/v1/query.proto",
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/andromedad.feeburn.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Reset, String and ProtoMessage satisfy proto.Message, which the gRPC
+// codec requires to (de)serialize these types. Neither carries
+// proto-generated fields, so these are hand-written rather than generated.
+func (m *QueryParamsRequest) Reset()                      { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string              { return "QueryParamsRequest{}" }
+func (*QueryParamsRequest) ProtoMessage()                 {}
+func (m *QueryParamsRequest) Size() int                   { return 0 }
+func (m *QueryParamsRequest) Marshal() ([]byte, error)    { return []byte{}, nil }
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error { return nil }
+
+func (m *QueryParamsResponse) Reset() { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string {
+	return fmt.Sprintf("QueryParamsResponse{Params: %s}", m.Params.FeeBurnPercent)
+}
+func (*QueryParamsResponse) ProtoMessage() {}
+
+// Size, Marshal and Unmarshal encode Params as a length-delimited field 1,
+// the same shape protoc-gen-gogo emits for an embedded message field.
+func (m *QueryParamsResponse) Size() (n int) {
+	if l := m.Params.Size(); l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsResponse) Marshal() ([]byte, error) {
+	dAtA := make([]byte, 0, m.Size())
+
+	if l := m.Params.Size(); l > 0 {
+		bz, err := m.Params.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		dAtA = append(dAtA, 0xa)
+		dAtA = appendVarint(dAtA, uint64(l))
+		dAtA = append(dAtA, bz...)
+	}
+
+	return dAtA, nil
+}
+
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	for iNdEx := 0; iNdEx < len(dAtA); {
+		tag, n, err := decodeVarint(dAtA[iNdEx:])
+		if err != nil {
+			return err
+		}
+		iNdEx += n
+
+		fieldNum, wireType := tag>>3, tag&0x7
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType %d for field Params", wireType)
+			}
+			value, n, err := decodeLengthDelimited(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if err := m.Params.Unmarshal(value); err != nil {
+				return err
+			}
+			iNdEx += n
+		default:
+			return fmt.Errorf("proto: unknown field number %d", fieldNum)
+		}
+	}
+
+	return nil
+}